@@ -26,18 +26,51 @@ type Arbiter struct {
 	children sync.Map
 	parent   *Arbiter
 
-	preStop   func()
-	afterStop func()
+	clock Clock
+
+	supervisorMu     sync.Mutex
+	supervisorErrors []*SupervisorError
+
+	errMu     sync.Mutex
+	fibreErrs []error
+
+	hooksMu           sync.Mutex
+	nextHookID        uint64
+	preStopHooks      []hookEntry
+	stoppedHooks      []hookEntry
+	childAddedHooks   []hookEntry
+	childRemovedHooks []hookEntry
+	panicHooks        []hookEntry
+
+	statsMu   sync.Mutex
+	poolStats *PoolStats
 }
 
 // NewWithParent creates a new arbiter atteched to specified parent arbiter.
 // The arbiter will be shut down by the parent or a call to Arbiter.Shutdown().
+// The new arbiter inherits its parent's clock, or the real wall clock if parent is nil.
 func NewWithParent(parent *Arbiter) *Arbiter {
+	var clock Clock = realClock{}
+	if parent != nil {
+		clock = parent.clock
+	}
+	return NewWithParentAndClock(parent, clock)
+}
+
+// NewWithClock creates a new root arbiter driven by the given clock.
+func NewWithClock(clock Clock) *Arbiter {
+	return NewWithParentAndClock(nil, clock)
+}
+
+// NewWithParentAndClock creates a new arbiter attached to parent, driven by clock
+// instead of the clock parent would otherwise be inherited from.
+func NewWithParentAndClock(parent *Arbiter, clock Clock) *Arbiter {
 	a := &Arbiter{
 		sigFibreExit: make(chan struct{}, 10),
 		sigOS:        make(chan os.Signal, 0),
 		lock:         make(chan struct{}, 1),
 		parent:       parent,
+		clock:        clock,
 		ended:        0,
 	}
 	a.lock <- struct{}{}
@@ -59,14 +92,19 @@ func NewWithParent(parent *Arbiter) *Arbiter {
 
 		// join parent.
 		parent.children.Store(a, struct{}{})
-		parent.Go(func() {
+		parent.fireChildAdded(a)
+		parent.goInternal(func() {
 			a.Join()
 
 			// Corner case: when a new arbiter is creating with a shutting down parent, `ended` flag may be not correctly set.
 			// Passively set `ended` flag prevent this case.
 			atomic.StoreUint32(&a.ended, 1)
 
+			// Bubble this child's collected errors up to the parent before it vanishes from children.
+			parent.recordError(a.Err())
+
 			parent.children.Delete(a)
+			parent.fireChildRemoved(a)
 		})
 	} else {
 		go func() {
@@ -95,8 +133,10 @@ func (a *Arbiter) NumGoroutine() (n int32) {
 	return
 }
 
-// Go spawns the proc (act the same as the "go" keyword) and let the arbiter traces it.
-func (a *Arbiter) Go(proc func()) *Arbiter {
+// goInternal spawns proc untracked by the shutdown guard that protects Go/GoE.
+// It backs Arbiter's own bookkeeping goroutines (Join's exit watcher, a child's
+// parent-side watcher), which must run even once the arbiter has ended.
+func (a *Arbiter) goInternal(proc func()) *Arbiter {
 	atomic.AddInt32(&a.runningCount, 1)
 	go func() {
 		defer func() {
@@ -107,13 +147,47 @@ func (a *Arbiter) Go(proc func()) *Arbiter {
 	return a
 }
 
-// TickGo spawns proc with specified period.
+// Go spawns the proc (act the same as the "go" keyword) and let the arbiter traces it.
+// A panic in proc is recovered and reported through OnPanic instead of unwinding
+// the goroutine unnoticed. Supervision (whether and how proc is relaunched after it
+// returns) is configured via opts; with no opts, proc runs exactly once, matching the
+// historic behaviour of Go.
+//
+// Calling Go on an arbiter that has already shut down does not spawn proc; instead
+// it records ErrAlreadyShutdown or ErrParentShutdown (retrievable via Err), avoiding
+// a runningCount that would otherwise never be decremented.
+func (a *Arbiter) Go(proc func(), opts ...GoOption) *Arbiter {
+	if !a.ShouldRun() {
+		a.recordError(a.shutdownErr())
+		return a
+	}
+	cfg := newGoConfig(opts)
+	return a.goInternal(func() { a.supervise(proc, cfg) })
+}
+
+// GoE is like Go but proc may return an error. Returned errors, and panics
+// recovered from proc, are collected (retrievable via Err) instead of being
+// silently discarded or crashing the process.
+func (a *Arbiter) GoE(proc func() error, opts ...GoOption) *Arbiter {
+	if !a.ShouldRun() {
+		a.recordError(a.shutdownErr())
+		return a
+	}
+	cfg := newGoConfig(opts)
+	return a.goInternal(func() { a.superviseE(proc, cfg) })
+}
+
+// TickGo spawns proc with specified period. The ticks are driven by the
+// arbiter's Clock, so tests may use a MockClock to advance time deterministically.
+// brust long-lived goroutines block directly on the ticker, so a slow proc
+// coalesces ticks with no visibility into the misses; see TickGoPool for a
+// bounded queue and elastic worker pool with an explicit overflow policy.
 func (a *Arbiter) TickGo(proc func(func(), time.Time), period time.Duration, brust uint32) (cancel func()) {
 	if brust < 1 {
 		return
 	}
 	var tickCtx context.Context
-	ticker := time.NewTicker(period)
+	ticker := a.clock.NewTicker(period)
 	tickCtx, cancel = context.WithCancel(a.ctx)
 
 	a.Go(func() {
@@ -126,7 +200,7 @@ func (a *Arbiter) TickGo(proc func(func(), time.Time), period time.Duration, bru
 		a.Go(func() {
 			for {
 				select {
-				case t := <-ticker.C:
+				case t := <-ticker.C():
 					a.Do(func() { proc(cancel, t.Add(period)) })
 
 				case <-tickCtx.Done():
@@ -138,6 +212,39 @@ func (a *Arbiter) TickGo(proc func(func(), time.Time), period time.Duration, bru
 	return
 }
 
+// TickGoE is like TickGo but proc may return an error, which is collected
+// (retrievable via Err) instead of being silently discarded.
+func (a *Arbiter) TickGoE(proc func(func(), time.Time) error, period time.Duration, brust uint32) (cancel func()) {
+	if brust < 1 {
+		return
+	}
+	var tickCtx context.Context
+	ticker := a.clock.NewTicker(period)
+	tickCtx, cancel = context.WithCancel(a.ctx)
+
+	a.Go(func() {
+		defer ticker.Stop()
+		<-tickCtx.Done()
+		ticker.Stop()
+	})
+
+	for idx := uint32(0); idx < brust; idx++ {
+		a.Go(func() {
+			for {
+				select {
+				case t := <-ticker.C():
+					deadline := t.Add(period)
+					a.DoE(func() error { return proc(cancel, deadline) })
+
+				case <-tickCtx.Done():
+					return
+				}
+			}
+		})
+	}
+	return
+}
+
 // Do calls the proc.
 func (a *Arbiter) Do(proc func()) *Arbiter {
 	atomic.AddInt32(&a.runningCount, 1)
@@ -148,6 +255,20 @@ func (a *Arbiter) Do(proc func()) *Arbiter {
 	return a
 }
 
+// DoE is like Do but proc may return an error, and a panic in proc is recovered.
+// Either is collected (retrievable via Err) instead of being silently discarded
+// or crashing the process.
+func (a *Arbiter) DoE(proc func() error) *Arbiter {
+	atomic.AddInt32(&a.runningCount, 1)
+	defer func() {
+		a.sigFibreExit <- struct{}{}
+	}()
+	if err, _ := a.runProtectedE(proc); err != nil {
+		a.recordError(err)
+	}
+	return a
+}
+
 // ShouldRun is called by goroutines traced by Arbiter, indicating whether the goroutines should continue to execute.
 func (a *Arbiter) ShouldRun() bool {
 	return atomic.LoadUint32(&a.ended) < 1
@@ -194,12 +315,9 @@ func (a *Arbiter) Join() {
 	defer func() { a.lock <- struct{}{} }()
 
 	if a.NumGoroutine() > 0 || a.ShouldRun() {
-		a.Go(func() {
+		a.goInternal(func() {
 			<-a.Exit()
-			preStop := a.preStop
-			if preStop != nil {
-				preStop()
-			}
+			a.firePreStop()
 		})
 
 		c := a.runningCount
@@ -214,28 +332,25 @@ func (a *Arbiter) Join() {
 				}
 			}
 		}
-		afterStop := a.afterStop
-		if afterStop != nil {
-			afterStop()
-		}
+		a.fireStopped()
 	}
 }
 
-// Arbit configures SIGKILL and SIGINT as shutting down signal and waits until all goroutines exited.
+// Arbit configures SIGTERM and SIGINT as shutting down signal and waits until all
+// goroutines exited. It does not report collected errors; callers that need the
+// joined error from GoE/TickGoE/DoE failures should use ArbitE instead. This keeps
+// Arbit's original signature-compatible behaviour intact for existing callers.
 func (a *Arbiter) Arbit() error {
 	a.StopOSSignals(syscall.SIGTERM, syscall.SIGINT)
 	a.Join()
 	return nil
 }
 
-// HookPreStop inserts pre-stop (a shutdown triggered) callback function.
-func (a *Arbiter) HookPreStop(proc func()) *Arbiter {
-	a.preStop = proc
-	return a
-}
-
-// HookStopped inserts after-stop (all goroutines and executions finished) callback function.
-func (a *Arbiter) HookStopped(proc func()) *Arbiter {
-	a.afterStop = proc
-	return a
+// ArbitE is like Arbit but returns the joined error (see Err) of every
+// GoE/TickGoE/DoE failure from this arbiter and, recursively, its children, or
+// nil if none failed.
+func (a *Arbiter) ArbitE() error {
+	a.StopOSSignals(syscall.SIGTERM, syscall.SIGINT)
+	a.Join()
+	return a.Err()
 }
@@ -1,6 +1,7 @@
 package arbiter
 
 import (
+	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -93,23 +94,42 @@ func TestArbiter(t *testing.T) {
 
 	t.Run("test_tick_go_surge", func(t *testing.T) {
 		t.Parallel()
-		p := New()
+		clock := NewMockClock(time.Unix(0, 0))
+		p := NewWithClock(clock)
+
+		concurrency, maxConcurrency := int32(0), int32(0)
+		started := make(chan struct{}, 3)
+		release := make(chan struct{})
 
-		concurrency := int32(0)
 		p.TickGo(func(cancel func(), deadline time.Time) {
-			assert.LessOrEqual(t, atomic.AddInt32(&concurrency, 1), int32(3))
-			time.Sleep(time.Millisecond * 600)
+			c := atomic.AddInt32(&concurrency, 1)
+			for {
+				if old := atomic.LoadInt32(&maxConcurrency); c <= old || atomic.CompareAndSwapInt32(&maxConcurrency, old, c) {
+					break
+				}
+			}
+			started <- struct{}{}
+			<-release
 			atomic.AddInt32(&concurrency, -1)
 		}, time.Millisecond*100, 3)
 
-		time.Sleep(time.Second * 3)
+		period := time.Millisecond * 100
+		for i := 0; i < 3; i++ {
+			clock.Add(period)
+			<-started
+		}
+		assert.Equal(t, int32(3), atomic.LoadInt32(&concurrency))
+
+		close(release)
 		p.Shutdown()
 		p.Join()
+		assert.LessOrEqual(t, atomic.LoadInt32(&maxConcurrency), int32(3))
 	})
 
 	t.Run("test_tick_go_period", func(t *testing.T) {
 		t.Parallel()
-		p := New()
+		clock := NewMockClock(time.Unix(0, 0))
+		p := NewWithClock(clock)
 
 		var last time.Time
 
@@ -117,12 +137,14 @@ func TestArbiter(t *testing.T) {
 
 		p.TickGo(func(cancel func(), deadline time.Time) {
 			if !first {
-				assert.WithinDuration(t, last.Add(period), deadline, time.Millisecond*5)
+				assert.Equal(t, last.Add(period), deadline)
 			}
 			last, first = deadline, false
 		}, time.Millisecond*100, 1)
 
-		time.Sleep(time.Second)
+		for i := 0; i < 10; i++ {
+			clock.Add(time.Millisecond * 100)
+		}
 		p.Shutdown()
 		p.Join()
 	})
@@ -143,4 +165,337 @@ func TestArbiter(t *testing.T) {
 		assert.True(t, prestopped)
 		assert.True(t, stopped)
 	})
+
+	t.Run("go_panic_recovers_and_hooks", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+
+		var recovered interface{}
+		p.HookPanic(func(r interface{}, stack []byte) {
+			recovered = r
+			assert.NotEmpty(t, stack)
+		})
+
+		p.Go(func() { panic("boom") })
+		p.Shutdown()
+		p.Join()
+
+		assert.Equal(t, "boom", recovered)
+	})
+
+	t.Run("go_restarts_on_panic", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+
+		var attempts int32
+		p.Go(func() {
+			if atomic.AddInt32(&attempts, 1) < 3 {
+				panic("retry me")
+			}
+		}, WithRestartPolicy(OnPanic))
+
+		assert.Eventually(t, func() bool { return atomic.LoadInt32(&attempts) == 3 }, time.Second, time.Millisecond)
+		p.Shutdown()
+		p.Join()
+		assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	})
+
+	t.Run("go_escalates_exceeded_restart_budget", func(t *testing.T) {
+		t.Parallel()
+		clock := NewMockClock(time.Unix(0, 0))
+		parent := NewWithClock(clock)
+		child := NewWithParent(parent)
+
+		child.Go(func() {
+			panic("always fails")
+		}, WithRestartPolicy(MaxRestarts(2, time.Minute)), WithEscalation(true))
+
+		child.Join()
+
+		assert.False(t, parent.ShouldRun())
+		if errs := parent.SupervisorErrors(); assert.Len(t, errs, 1) {
+			assert.Same(t, child, errs[0].Arbiter)
+		}
+	})
+
+	t.Run("goe_collects_errors_and_panics", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+
+		failure := errors.New("proc failed")
+		p.GoE(func() error { return failure })
+		p.GoE(func() error { panic("boom") })
+		p.Shutdown()
+		p.Join()
+
+		err := p.Err()
+		assert.True(t, errors.Is(err, failure))
+		assert.ErrorContains(t, err, "boom")
+	})
+
+	t.Run("go_and_goe_reject_shutdown_arbiter", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+		p.Shutdown()
+		p.Join()
+
+		ran := false
+		p.Go(func() { ran = true })
+		p.GoE(func() error { ran = true; return nil })
+
+		assert.False(t, ran)
+		assert.ErrorIs(t, p.Err(), ErrAlreadyShutdown)
+	})
+
+	t.Run("go_rejects_child_of_shutdown_parent", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+		p.Shutdown()
+		p.Join()
+
+		child := NewWithParent(p)
+		child.Go(func() {})
+
+		assert.ErrorIs(t, child.Err(), ErrParentShutdown)
+	})
+
+	t.Run("tick_goe_collects_errors", func(t *testing.T) {
+		t.Parallel()
+		clock := NewMockClock(time.Unix(0, 0))
+		p := NewWithClock(clock)
+
+		failure := errors.New("tick failed")
+		p.TickGoE(func(cancel func(), deadline time.Time) error {
+			return failure
+		}, time.Millisecond*100, 1)
+
+		clock.Add(time.Millisecond * 100)
+		p.Shutdown()
+		p.Join()
+
+		assert.ErrorIs(t, p.Err(), failure)
+	})
+
+	t.Run("arbit_returns_joined_error", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+
+		failure := errors.New("background task failed")
+		p.GoE(func() error { return failure })
+		p.Shutdown()
+
+		assert.ErrorIs(t, p.ArbitE(), failure)
+	})
+
+	t.Run("arbit_stays_backward_compatible", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+
+		failure := errors.New("background task failed")
+		p.GoE(func() error { return failure })
+		p.Shutdown()
+
+		assert.NoError(t, p.Arbit())
+	})
+
+	t.Run("on_pre_stop_and_stopped_support_multiple_subscribers", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+
+		var order []string
+		p.OnPreStop(func() { order = append(order, "pre-stop-1") })
+		p.OnPreStop(func() { order = append(order, "pre-stop-2") })
+		p.OnStopped(func() { order = append(order, "stopped-1") })
+		p.OnStopped(func() { order = append(order, "stopped-2") })
+
+		spawnRoutines(p, 1, nil)
+		p.Shutdown()
+		p.Join()
+
+		assert.Equal(t, []string{"pre-stop-1", "pre-stop-2", "stopped-1", "stopped-2"}, order)
+	})
+
+	t.Run("on_pre_stop_unsubscribe", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+
+		called := false
+		unsubscribe := p.OnPreStop(func() { called = true })
+		unsubscribe()
+
+		p.Shutdown()
+		p.Join()
+
+		assert.False(t, called)
+	})
+
+	t.Run("on_child_added_and_removed", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+
+		var added *Arbiter
+		removed := make(chan *Arbiter, 1)
+		p.OnChildAdded(func(child *Arbiter) { added = child })
+		p.OnChildRemoved(func(child *Arbiter) { removed <- child })
+
+		child := NewWithParent(p)
+		assert.Same(t, child, added)
+
+		child.Shutdown()
+		assert.Same(t, child, <-removed)
+	})
+
+	t.Run("tick_go_pool_processes_queued_ticks", func(t *testing.T) {
+		t.Parallel()
+		clock := NewMockClock(time.Unix(0, 0))
+		p := NewWithClock(clock)
+
+		var processed int32
+		p.TickGoPool(func(cancel func(), deadline time.Time) {
+			atomic.AddInt32(&processed, 1)
+		}, time.Millisecond*100, PoolConfig{MinWorkers: 2, QueueSize: 8})
+
+		for i := 0; i < 5; i++ {
+			clock.Add(time.Millisecond * 100)
+		}
+
+		assert.Eventually(t, func() bool { return atomic.LoadInt32(&processed) == 5 }, time.Second, time.Millisecond)
+		assert.EqualValues(t, 5, p.Stats().QueuedTicks())
+		assert.EqualValues(t, 0, p.Stats().MissedTicks())
+
+		p.Shutdown()
+		p.Join()
+	})
+
+	t.Run("tick_go_pool_drop_oldest_overflow", func(t *testing.T) {
+		t.Parallel()
+		clock := NewMockClock(time.Unix(0, 0))
+		p := NewWithClock(clock)
+
+		release := make(chan struct{})
+		p.TickGoPool(func(cancel func(), deadline time.Time) {
+			<-release
+		}, time.Millisecond*100, PoolConfig{MinWorkers: 1, QueueSize: 1, OnOverflow: DropOldest})
+
+		for i := 0; i < 4; i++ {
+			clock.Add(time.Millisecond * 100)
+		}
+
+		assert.Eventually(t, func() bool { return p.Stats().QueuedTicks() == 4 }, time.Second, time.Millisecond)
+		assert.EqualValues(t, 2, p.Stats().MissedTicks())
+
+		close(release)
+		p.Shutdown()
+		p.Join()
+	})
+
+	t.Run("tick_go_pool_expand_adds_workers", func(t *testing.T) {
+		t.Parallel()
+		clock := NewMockClock(time.Unix(0, 0))
+		p := NewWithClock(clock)
+
+		release := make(chan struct{})
+		p.TickGoPool(func(cancel func(), deadline time.Time) {
+			<-release
+		}, time.Millisecond*100, PoolConfig{MinWorkers: 1, MaxWorkers: 3, QueueSize: 1, OnOverflow: Expand})
+
+		for i := 0; i < 6; i++ {
+			clock.Add(time.Millisecond * 100)
+		}
+
+		assert.Eventually(t, func() bool { return p.Stats().ActiveWorkers() == 3 }, time.Second, time.Millisecond)
+
+		close(release)
+		p.Shutdown()
+		p.Join()
+	})
+
+	t.Run("tick_go_pool_block_overflow_shuts_down", func(t *testing.T) {
+		t.Parallel()
+		// Uses the real clock rather than MockClock: the scenario needs the
+		// tick dispatcher to genuinely be parked inside push's OnOverflow:
+		// Block wait when Shutdown happens, which isn't worth the ceremony of
+		// stepping a mock clock concurrently with a background goroutine.
+		p := New()
+
+		release := make(chan struct{})
+		p.TickGoPool(func(cancel func(), deadline time.Time) {
+			<-release
+		}, time.Millisecond*5, PoolConfig{MinWorkers: 1, QueueSize: 1, OnOverflow: Block})
+
+		// Give the real ticker time to fill the queue and park the dispatcher
+		// in Block, waiting on the sole worker, which stays busy on release
+		// for the rest of the test.
+		time.Sleep(time.Millisecond * 50)
+
+		p.Shutdown()
+
+		// Without something waking the parked push, the dispatcher would never
+		// notice tickCtx.Done(), so drainTickPool would never run and this tick
+		// would never get dropped/reported. Deliberately checked via Err/Stats
+		// rather than Join: the busy worker's in-flight tick is only released
+		// below, and Join must legitimately wait for it to return.
+		assert.Eventually(t, func() bool { return p.Err() != nil }, 3*time.Second, time.Millisecond)
+		assert.ErrorContains(t, p.Err(), "dropped")
+
+		close(release)
+		p.Join()
+	})
+
+	t.Run("tick_go_pool_grace_shutdown_drops_after_timeout", func(t *testing.T) {
+		t.Parallel()
+		clock := NewMockClock(time.Unix(0, 0))
+		p := NewWithClock(clock)
+
+		release := make(chan struct{})
+		p.TickGoPool(func(cancel func(), deadline time.Time) {
+			<-release
+		}, time.Millisecond*100, PoolConfig{
+			MinWorkers:    1,
+			QueueSize:     4,
+			OnOverflow:    DropNewest,
+			GraceShutdown: time.Millisecond * 50,
+		})
+
+		// The first tick occupies the sole worker (blocked on release); the
+		// other two sit queued and are still there once grace expires.
+		for i := 0; i < 3; i++ {
+			clock.Add(time.Millisecond * 100)
+		}
+		assert.Eventually(t, func() bool { return p.Stats().QueuedTicks() == 3 }, time.Second, time.Millisecond)
+
+		p.Shutdown()
+
+		assert.Eventually(t, func() bool {
+			clock.Add(time.Millisecond * 10)
+			return p.Err() != nil
+		}, time.Second, time.Millisecond)
+
+		assert.ErrorContains(t, p.Err(), "dropped 2 queued tick(s)")
+
+		close(release)
+		p.Join()
+	})
+
+	t.Run("stats_nil_without_tick_go_pool", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+		assert.Nil(t, p.Stats())
+	})
+
+	t.Run("on_panic_multiple_subscribers", func(t *testing.T) {
+		t.Parallel()
+		p := New()
+
+		var seen1, seen2 interface{}
+		p.OnPanic(func(r interface{}, stack []byte) { seen1 = r })
+		p.OnPanic(func(r interface{}, stack []byte) { seen2 = r })
+
+		p.Go(func() { panic("boom") })
+		p.Shutdown()
+		p.Join()
+
+		assert.Equal(t, "boom", seen1)
+		assert.Equal(t, "boom", seen2)
+	})
 }
@@ -0,0 +1,237 @@
+package arbiter
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock abstracts time so that arbiters (and their tests) do not have to depend
+// on the wall clock. The default Clock used by New and NewWithParent is backed
+// by the time package; MockClock is provided for deterministic tests.
+type Clock interface {
+	// Now returns the current time as seen by the clock.
+	Now() time.Time
+
+	// NewTicker returns a Ticker that fires every d.
+	NewTicker(d time.Duration) Ticker
+
+	// NewTimer returns a Timer that fires once after d.
+	NewTimer(d time.Duration) Timer
+
+	// Sleep blocks the calling goroutine for d.
+	Sleep(d time.Duration)
+}
+
+// Ticker mirrors time.Ticker behind the Clock abstraction.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// Timer mirrors time.Timer behind the Clock abstraction.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// realClock is the Clock backed by the actual wall clock.
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{time.NewTicker(d)}
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return &realTimer{time.NewTimer(d)}
+}
+
+func (realClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+type realTicker struct{ t *time.Ticker }
+
+func (r *realTicker) C() <-chan time.Time { return r.t.C }
+func (r *realTicker) Stop()               { r.t.Stop() }
+
+type realTimer struct{ t *time.Timer }
+
+func (r *realTimer) C() <-chan time.Time        { return r.t.C }
+func (r *realTimer) Stop() bool                 { return r.t.Stop() }
+func (r *realTimer) Reset(d time.Duration) bool { return r.t.Reset(d) }
+
+// mockSchedule is a single outstanding ticker or timer registered with a MockClock.
+type mockSchedule struct {
+	clock   *MockClock
+	seq     uint64
+	fireAt  time.Time
+	period  time.Duration // zero for a one-shot Timer
+	ch      chan time.Time
+	stopped bool
+}
+
+// MockClock is an in-memory Clock that only advances when Add or Set is called,
+// making tests built around it deterministic. Advancing the clock delivers every
+// due tick/timer in FIFO order of firing time, one at a time, over an unbuffered
+// channel: Add/Set do not return until each delivery has actually been received
+// by a consumer, matching the behaviour callers get from a real ticker/timer
+// being read by an already-running goroutine. This means a consumer must be
+// concurrently receiving (e.g. a goroutine started with Go/TickGo, or a test's
+// own helper goroutine parked on C()) while Add/Set runs; a caller that only
+// reads C() after Add/Set has returned, in the same goroutine, will deadlock
+// Add/Set against itself, since nothing else is around to take the delivery.
+type MockClock struct {
+	advanceLock sync.Mutex // serializes concurrent Add/Set calls
+
+	mu        sync.Mutex
+	now       time.Time
+	schedules []*mockSchedule
+	seq       uint64
+}
+
+// NewMockClock creates a MockClock starting at the given time.
+func NewMockClock(start time.Time) *MockClock {
+	return &MockClock{now: start}
+}
+
+// Now returns the clock's current (mock) time.
+func (m *MockClock) Now() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.now
+}
+
+// NewTicker registers a ticker that fires every d once the clock is advanced past it.
+func (m *MockClock) NewTicker(d time.Duration) Ticker {
+	return &mockTicker{m.schedule(d, d)}
+}
+
+// NewTimer registers a timer that fires once d after the current mock time.
+func (m *MockClock) NewTimer(d time.Duration) Timer {
+	return &mockTimer{m.schedule(d, 0)}
+}
+
+func (m *MockClock) schedule(d, period time.Duration) *mockSchedule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seq++
+	s := &mockSchedule{
+		clock:  m,
+		seq:    m.seq,
+		fireAt: m.now.Add(d),
+		period: period,
+		ch:     make(chan time.Time),
+	}
+	m.schedules = append(m.schedules, s)
+	return s
+}
+
+// Sleep blocks until the clock is advanced past d from now.
+func (m *MockClock) Sleep(d time.Duration) {
+	<-m.schedule(d, 0).ch
+}
+
+// Add advances the mock clock by d, delivering every due tick/timer along the way.
+func (m *MockClock) Add(d time.Duration) {
+	m.advanceLock.Lock()
+	defer m.advanceLock.Unlock()
+
+	m.mu.Lock()
+	target := m.now.Add(d)
+	m.mu.Unlock()
+	m.advanceTo(target)
+}
+
+// Set moves the mock clock directly to t, delivering every due tick/timer along the way.
+func (m *MockClock) Set(t time.Time) {
+	m.advanceLock.Lock()
+	defer m.advanceLock.Unlock()
+	m.advanceTo(t)
+}
+
+// advanceTo fires every outstanding schedule due at or before target, one at a
+// time in FIFO order of firing time. Each delivery is a synchronous send on the
+// schedule's unbuffered channel, so advanceTo (and thus Add/Set) blocks until a
+// consumer actually receives it — the acknowledgement the backlog's invariant
+// calls for is the channel receive itself, with no separate ack channel needed.
+func (m *MockClock) advanceTo(target time.Time) {
+	for {
+		due, fireAt, ch := m.nextDue(target)
+		if due == nil {
+			m.mu.Lock()
+			if m.now.Before(target) {
+				m.now = target
+			}
+			m.mu.Unlock()
+			return
+		}
+		ch <- fireAt
+	}
+}
+
+func (m *MockClock) nextDue(target time.Time) (due *mockSchedule, fireAt time.Time, ch chan time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	live := m.schedules[:0]
+	for _, s := range m.schedules {
+		if !s.stopped {
+			live = append(live, s)
+		}
+	}
+	m.schedules = live
+
+	sort.SliceStable(m.schedules, func(i, j int) bool {
+		if m.schedules[i].fireAt.Equal(m.schedules[j].fireAt) {
+			return m.schedules[i].seq < m.schedules[j].seq
+		}
+		return m.schedules[i].fireAt.Before(m.schedules[j].fireAt)
+	})
+
+	for _, s := range m.schedules {
+		if s.fireAt.After(target) {
+			break
+		}
+		due, fireAt, ch = s, s.fireAt, s.ch
+		m.now = fireAt
+		if s.period > 0 {
+			s.fireAt = s.fireAt.Add(s.period)
+		} else {
+			s.stopped = true
+		}
+		return
+	}
+	return nil, time.Time{}, nil
+}
+
+func (s *mockSchedule) C() <-chan time.Time { return s.ch }
+
+func (s *mockSchedule) stop() bool {
+	s.clock.mu.Lock()
+	defer s.clock.mu.Unlock()
+	wasRunning := !s.stopped
+	s.stopped = true
+	return wasRunning
+}
+
+func (s *mockSchedule) reset(d time.Duration) bool {
+	s.clock.mu.Lock()
+	defer s.clock.mu.Unlock()
+	wasRunning := !s.stopped
+	s.stopped = false
+	s.fireAt = s.clock.now.Add(d)
+	return wasRunning
+}
+
+// mockTicker adapts a mockSchedule to the Ticker interface.
+type mockTicker struct{ *mockSchedule }
+
+func (t *mockTicker) Stop() { t.stop() }
+
+// mockTimer adapts a mockSchedule to the Timer interface.
+type mockTimer struct{ *mockSchedule }
+
+func (t *mockTimer) Stop() bool                 { return t.stop() }
+func (t *mockTimer) Reset(d time.Duration) bool { return t.reset(d) }
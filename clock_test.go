@@ -0,0 +1,66 @@
+package arbiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockClock(t *testing.T) {
+	t.Run("ticker_fires_in_order", func(t *testing.T) {
+		t.Parallel()
+		start := time.Unix(0, 0)
+		clock := NewMockClock(start)
+
+		ticker := clock.NewTicker(time.Millisecond * 100)
+		timer := clock.NewTimer(time.Millisecond * 250)
+
+		// Add blocks until each delivery is actually received, so a consumer
+		// must already be concurrently receiving while it runs.
+		firstTick := make(chan time.Time, 1)
+		go func() { firstTick <- <-ticker.C() }()
+		clock.Add(time.Millisecond * 100)
+		assert.Equal(t, start.Add(time.Millisecond*100), <-firstTick)
+
+		// Advancing straight to 250ms fires the ticker's 200ms tick and the
+		// timer's 250ms fire, in that order; both need a concurrent receiver
+		// ready, so drain them in the background while Add runs.
+		secondTick, timerFire := make(chan time.Time, 1), make(chan time.Time, 1)
+		go func() { secondTick <- <-ticker.C() }()
+		go func() { timerFire <- <-timer.C() }()
+		clock.Add(time.Millisecond * 150)
+		assert.Equal(t, start.Add(time.Millisecond*200), <-secondTick)
+		assert.Equal(t, start.Add(time.Millisecond*250), <-timerFire)
+
+		ticker.Stop()
+	})
+
+	t.Run("set_moves_to_absolute_time", func(t *testing.T) {
+		t.Parallel()
+		start := time.Unix(0, 0)
+		clock := NewMockClock(start)
+
+		timer := clock.NewTimer(time.Second)
+		fired := make(chan time.Time, 1)
+		go func() { fired <- <-timer.C() }()
+		clock.Set(start.Add(time.Second))
+		assert.Equal(t, start.Add(time.Second), <-fired)
+		assert.Equal(t, start.Add(time.Second), clock.Now())
+	})
+
+	t.Run("stopped_timer_never_fires", func(t *testing.T) {
+		t.Parallel()
+		clock := NewMockClock(time.Unix(0, 0))
+
+		timer := clock.NewTimer(time.Millisecond * 100)
+		assert.True(t, timer.Stop())
+
+		done := make(chan struct{})
+		go func() {
+			clock.Add(time.Millisecond * 100)
+			close(done)
+		}()
+		<-done
+	})
+}
@@ -0,0 +1,37 @@
+package arbiter
+
+import "errors"
+
+// ErrAlreadyShutdown is returned (via Err) when Go or GoE is called on an
+// arbiter that has itself already been shut down.
+var ErrAlreadyShutdown = errors.New("arbiter: already shut down")
+
+// ErrParentShutdown is returned (via Err) when Go or GoE is called on an
+// arbiter whose shutdown was caused by a parent shutting down.
+var ErrParentShutdown = errors.New("arbiter: parent already shut down")
+
+func (a *Arbiter) shutdownErr() error {
+	if a.parent != nil && !a.parent.ShouldRun() {
+		return ErrParentShutdown
+	}
+	return ErrAlreadyShutdown
+}
+
+func (a *Arbiter) recordError(err error) {
+	if err == nil {
+		return
+	}
+	a.errMu.Lock()
+	defer a.errMu.Unlock()
+	a.fibreErrs = append(a.fibreErrs, err)
+}
+
+// Err returns the errors collected from this arbiter's tracked goroutines
+// (via GoE/TickGoE/DoE) and, recursively, its children, joined with errors.Join.
+// A child's errors are added only once that child has fully joined; before then
+// they are not included. It returns nil if nothing failed.
+func (a *Arbiter) Err() error {
+	a.errMu.Lock()
+	defer a.errMu.Unlock()
+	return errors.Join(a.fibreErrs...)
+}
@@ -0,0 +1,127 @@
+package arbiter
+
+import "log"
+
+// hookEntry is one subscription in a lifecycle event bus, keyed by id so it can
+// be removed again by the unsubscribe func returned from the On* methods.
+type hookEntry struct {
+	id uint64
+	fn interface{}
+}
+
+func (a *Arbiter) subscribe(hooks *[]hookEntry, fn interface{}) (unsubscribe func()) {
+	a.hooksMu.Lock()
+	a.nextHookID++
+	id := a.nextHookID
+	*hooks = append(*hooks, hookEntry{id: id, fn: fn})
+	a.hooksMu.Unlock()
+
+	return func() {
+		a.hooksMu.Lock()
+		defer a.hooksMu.Unlock()
+		for i, h := range *hooks {
+			if h.id == id {
+				*hooks = append((*hooks)[:i], (*hooks)[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// OnPreStop subscribes fn to run when the arbiter's shutdown is triggered, before
+// Join waits for tracked goroutines to exit. Subscribers fire in registration order.
+func (a *Arbiter) OnPreStop(fn func()) (unsubscribe func()) {
+	return a.subscribe(&a.preStopHooks, fn)
+}
+
+// OnStopped subscribes fn to run once all of the arbiter's tracked goroutines and
+// executions have finished. Subscribers fire in registration order.
+func (a *Arbiter) OnStopped(fn func()) (unsubscribe func()) {
+	return a.subscribe(&a.stoppedHooks, fn)
+}
+
+// OnChildAdded subscribes fn to run whenever a child arbiter attaches to this one.
+func (a *Arbiter) OnChildAdded(fn func(child *Arbiter)) (unsubscribe func()) {
+	return a.subscribe(&a.childAddedHooks, fn)
+}
+
+// OnChildRemoved subscribes fn to run whenever a child arbiter detaches from this
+// one, which happens once the child has fully joined.
+func (a *Arbiter) OnChildRemoved(fn func(child *Arbiter)) (unsubscribe func()) {
+	return a.subscribe(&a.childRemovedHooks, fn)
+}
+
+// OnPanic subscribes fn to run whenever a goroutine tracked by Go or GoE, or a
+// proc run by DoE, panics, with the recovered value and the stack captured at the
+// panic site. Do does not recover panics itself; a panic in Do reaches fn only
+// when that Do runs inside a goroutine tracked by Go or GoE.
+func (a *Arbiter) OnPanic(fn func(recovered interface{}, stack []byte)) (unsubscribe func()) {
+	return a.subscribe(&a.panicHooks, fn)
+}
+
+func (a *Arbiter) snapshotHooks(hooks *[]hookEntry) []hookEntry {
+	a.hooksMu.Lock()
+	defer a.hooksMu.Unlock()
+	return append([]hookEntry(nil), *hooks...)
+}
+
+func (a *Arbiter) firePreStop() {
+	for _, h := range a.snapshotHooks(&a.preStopHooks) {
+		h.fn.(func())()
+	}
+}
+
+func (a *Arbiter) fireStopped() {
+	for _, h := range a.snapshotHooks(&a.stoppedHooks) {
+		h.fn.(func())()
+	}
+}
+
+func (a *Arbiter) fireChildAdded(child *Arbiter) {
+	for _, h := range a.snapshotHooks(&a.childAddedHooks) {
+		h.fn.(func(*Arbiter))(child)
+	}
+}
+
+func (a *Arbiter) fireChildRemoved(child *Arbiter) {
+	for _, h := range a.snapshotHooks(&a.childRemovedHooks) {
+		h.fn.(func(*Arbiter))(child)
+	}
+}
+
+func (a *Arbiter) firePanic(recovered interface{}, stack []byte) {
+	for _, h := range a.snapshotHooks(&a.panicHooks) {
+		h.fn.(func(interface{}, []byte))(recovered, stack)
+	}
+}
+
+// HookPreStop inserts pre-stop (a shutdown triggered) callback function.
+//
+// Deprecated: a second call used to silently overwrite the first. Use OnPreStop,
+// which supports any number of independent subscribers.
+func (a *Arbiter) HookPreStop(proc func()) *Arbiter {
+	log.Println("arbiter: HookPreStop is deprecated, use OnPreStop instead")
+	a.OnPreStop(proc)
+	return a
+}
+
+// HookStopped inserts after-stop (all goroutines and executions finished) callback function.
+//
+// Deprecated: a second call used to silently overwrite the first. Use OnStopped,
+// which supports any number of independent subscribers.
+func (a *Arbiter) HookStopped(proc func()) *Arbiter {
+	log.Println("arbiter: HookStopped is deprecated, use OnStopped instead")
+	a.OnStopped(proc)
+	return a
+}
+
+// HookPanic inserts the callback invoked whenever a goroutine tracked by Go or
+// TickGo panics, with the recovered value and the stack captured at the panic site.
+//
+// Deprecated: a second call used to silently overwrite the first. Use OnPanic,
+// which supports any number of independent subscribers.
+func (a *Arbiter) HookPanic(proc func(recovered interface{}, stack []byte)) *Arbiter {
+	log.Println("arbiter: HookPanic is deprecated, use OnPanic instead")
+	a.OnPanic(proc)
+	return a
+}
@@ -0,0 +1,278 @@
+package arbiter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// OverflowPolicy decides what TickGoPool does when a tick arrives and the queue
+// is already at QueueSize.
+type OverflowPolicy int
+
+const (
+	// DropOldest discards the longest-queued tick to make room for the new one.
+	DropOldest OverflowPolicy = iota
+	// DropNewest discards the tick that just arrived, keeping the queue as-is.
+	DropNewest
+	// Block makes the tick producer wait until a worker frees up queue space.
+	Block
+	// Expand spawns an additional worker (up to MaxWorkers) to absorb the tick,
+	// falling back to DropOldest once MaxWorkers is reached.
+	Expand
+)
+
+// PoolConfig configures a TickGoPool worker pool.
+type PoolConfig struct {
+	// MinWorkers is the number of workers started up front. Defaults to 1.
+	MinWorkers int
+	// MaxWorkers bounds how many workers Expand may spin up. Defaults to MinWorkers.
+	MaxWorkers int
+	// QueueSize bounds how many pending ticks may queue up. Defaults to 1.
+	QueueSize int
+	// OnOverflow selects the backpressure policy applied once QueueSize is reached.
+	OnOverflow OverflowPolicy
+	// GraceShutdown bounds how long the pool waits, once the arbiter starts
+	// shutting down, for queued ticks to drain before the rest are dropped.
+	GraceShutdown time.Duration
+}
+
+func (cfg *PoolConfig) normalize() {
+	if cfg.MinWorkers < 1 {
+		cfg.MinWorkers = 1
+	}
+	if cfg.MaxWorkers < cfg.MinWorkers {
+		cfg.MaxWorkers = cfg.MinWorkers
+	}
+	if cfg.QueueSize < 1 {
+		cfg.QueueSize = 1
+	}
+}
+
+// PoolStats exposes the live counters of a TickGoPool worker pool (see Arbiter.Stats).
+type PoolStats struct {
+	missedTicks   int64
+	queuedTicks   int64
+	activeWorkers int32
+}
+
+// MissedTicks returns the number of ticks dropped by the pool's overflow policy.
+func (s *PoolStats) MissedTicks() int64 { return atomic.LoadInt64(&s.missedTicks) }
+
+// QueuedTicks returns the number of ticks ever accepted onto the pool's queue.
+func (s *PoolStats) QueuedTicks() int64 { return atomic.LoadInt64(&s.queuedTicks) }
+
+// ActiveWorkers returns the number of workers currently provisioned by the pool.
+func (s *PoolStats) ActiveWorkers() int32 { return atomic.LoadInt32(&s.activeWorkers) }
+
+// tickPool is the bounded queue and elastic worker count backing TickGoPool.
+type tickPool struct {
+	cfg PoolConfig
+
+	mu       sync.Mutex
+	cond     *sync.Cond
+	queue    []time.Time
+	workers  int
+	closed   bool
+	stopping bool // shutdown has begun; wakes a Block-blocked push so it can drop instead of hanging
+
+	stats *PoolStats
+}
+
+func newTickPool(cfg PoolConfig) *tickPool {
+	p := &tickPool{cfg: cfg, stats: &PoolStats{}}
+	p.cond = sync.NewCond(&p.mu)
+	return p
+}
+
+// push enqueues deadline according to the pool's OverflowPolicy, spawning an
+// extra worker via spawnWorker under Expand if MaxWorkers hasn't been reached yet.
+func (p *tickPool) push(deadline time.Time, spawnWorker func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for len(p.queue) >= p.cfg.QueueSize && !p.closed {
+		switch p.cfg.OnOverflow {
+		case DropOldest:
+			p.queue = p.queue[1:]
+			atomic.AddInt64(&p.stats.missedTicks, 1)
+
+		case DropNewest:
+			atomic.AddInt64(&p.stats.missedTicks, 1)
+			return
+
+		case Expand:
+			if p.workers < p.cfg.MaxWorkers {
+				p.workers++
+				spawnWorker()
+			} else {
+				p.queue = p.queue[1:]
+				atomic.AddInt64(&p.stats.missedTicks, 1)
+			}
+
+		case Block:
+			// A full queue with no worker draining it would otherwise wait
+			// here forever, including past shutdown: notifyStopping wakes us
+			// so we can drop the tick instead of wedging the tick dispatcher.
+			if p.stopping {
+				atomic.AddInt64(&p.stats.missedTicks, 1)
+				return
+			}
+			p.cond.Wait()
+			continue
+		}
+		break
+	}
+	if p.closed {
+		return
+	}
+
+	p.queue = append(p.queue, deadline)
+	atomic.AddInt64(&p.stats.queuedTicks, 1)
+	p.cond.Signal()
+}
+
+// pop removes and returns the oldest queued deadline, blocking until one is
+// available. ok is false once the pool has been closed and drained.
+func (p *tickPool) pop() (deadline time.Time, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for len(p.queue) == 0 && !p.closed {
+		p.cond.Wait()
+	}
+	if len(p.queue) == 0 {
+		return time.Time{}, false
+	}
+	deadline, p.queue = p.queue[0], p.queue[1:]
+	p.cond.Signal()
+	return deadline, true
+}
+
+// notifyStopping marks the pool as shutting down and wakes any push currently
+// parked in OnOverflow: Block, so it can drop its tick and return rather than
+// wait indefinitely for a worker that drainTickPool may give up on first.
+func (p *tickPool) notifyStopping() {
+	p.mu.Lock()
+	p.stopping = true
+	p.cond.Broadcast()
+	p.mu.Unlock()
+}
+
+// close stops the pool from accepting new deadlines and wakes every blocked
+// pusher/popper; queued-but-undrained deadlines remain until drain is called.
+func (p *tickPool) close() {
+	p.mu.Lock()
+	p.closed = true
+	p.cond.Broadcast()
+	p.mu.Unlock()
+}
+
+// drain empties and returns whatever remains queued.
+func (p *tickPool) drain() []time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	remaining := p.queue
+	p.queue = nil
+	return remaining
+}
+
+// TickGoPool is like TickGo but backed by a bounded queue and an elastic pool of
+// workers (MinWorkers up to MaxWorkers) instead of exactly brust long-lived
+// goroutines, so a slow proc applies the configured OverflowPolicy instead of
+// silently coalescing ticks. Live counters are available via Stats.
+//
+// On shutdown, the pool drains its queue for up to cfg.GraceShutdown before giving
+// up; any ticks still queued at that point are dropped and reported via Err.
+func (a *Arbiter) TickGoPool(proc func(func(), time.Time), period time.Duration, cfg PoolConfig) (cancel func()) {
+	cfg.normalize()
+	pool := newTickPool(cfg)
+
+	a.statsMu.Lock()
+	a.poolStats = pool.stats
+	a.statsMu.Unlock()
+
+	ticker := a.clock.NewTicker(period)
+	var tickCtx context.Context
+	tickCtx, cancel = context.WithCancel(a.ctx)
+
+	var spawnWorker func()
+	spawnWorker = func() {
+		atomic.AddInt32(&pool.stats.activeWorkers, 1)
+		a.Go(func() {
+			defer atomic.AddInt32(&pool.stats.activeWorkers, -1)
+			for {
+				deadline, ok := pool.pop()
+				if !ok {
+					return
+				}
+				a.Do(func() { proc(cancel, deadline) })
+			}
+		})
+	}
+
+	pool.workers = cfg.MinWorkers
+	for i := 0; i < cfg.MinWorkers; i++ {
+		spawnWorker()
+	}
+
+	// Runs independently of the tick dispatcher below so that an OnOverflow:
+	// Block push stuck waiting for queue space doesn't also block this signal.
+	a.Go(func() {
+		<-tickCtx.Done()
+		pool.notifyStopping()
+	})
+
+	a.Go(func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case t := <-ticker.C():
+				pool.push(t.Add(period), spawnWorker)
+
+			case <-tickCtx.Done():
+				ticker.Stop()
+				a.drainTickPool(pool, cfg.GraceShutdown)
+				return
+			}
+		}
+	})
+
+	return
+}
+
+// drainTickPool waits up to grace for pool's queue to empty, then closes the pool
+// (letting idle workers exit) and reports any tick deadlines still queued at that
+// point as a dropped-item error via Err.
+func (a *Arbiter) drainTickPool(pool *tickPool, grace time.Duration) {
+	drained := make(chan struct{})
+	a.goInternal(func() {
+		pool.mu.Lock()
+		for len(pool.queue) > 0 && !pool.closed {
+			pool.cond.Wait()
+		}
+		pool.mu.Unlock()
+		close(drained)
+	})
+
+	timer := a.clock.NewTimer(grace)
+	defer timer.Stop()
+	select {
+	case <-drained:
+	case <-timer.C():
+	}
+
+	pool.close()
+	if dropped := pool.drain(); len(dropped) > 0 {
+		a.recordError(fmt.Errorf("arbiter: tick pool shutdown dropped %d queued tick(s) after grace period", len(dropped)))
+	}
+}
+
+// Stats returns the live counters of the worker pool started by TickGoPool on
+// this arbiter, or nil if TickGoPool has not been called.
+func (a *Arbiter) Stats() *PoolStats {
+	a.statsMu.Lock()
+	defer a.statsMu.Unlock()
+	return a.poolStats
+}
@@ -0,0 +1,257 @@
+package arbiter
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime/debug"
+	"time"
+)
+
+// restartKind selects when a supervised goroutine should be relaunched after it returns.
+type restartKind int
+
+const (
+	restartOneShot restartKind = iota
+	restartAlways
+	restartOnPanic
+	restartMaxRestarts
+)
+
+func (k restartKind) restartsOn(panicked bool) bool {
+	switch k {
+	case restartAlways:
+		return true
+	case restartOnPanic, restartMaxRestarts:
+		return panicked
+	default:
+		return false
+	}
+}
+
+// RestartPolicy decides whether a goroutine passed to Go should be relaunched
+// after it returns (normally or via panic).
+type RestartPolicy struct {
+	kind        restartKind
+	maxRestarts int
+	window      time.Duration
+}
+
+var (
+	// OneShot never relaunches proc; this is the default, matching Go's historic behaviour.
+	OneShot = RestartPolicy{kind: restartOneShot}
+
+	// Always relaunches proc every time it returns, whether it panicked or not.
+	Always = RestartPolicy{kind: restartAlways}
+
+	// OnPanic relaunches proc only when it panicked; a normal return ends supervision.
+	OnPanic = RestartPolicy{kind: restartOnPanic}
+)
+
+// MaxRestarts relaunches proc on panic, up to n times within a sliding window of
+// the given duration. Once the budget is exceeded, supervision ends and a
+// SupervisorError is recorded (see WithEscalation).
+func MaxRestarts(n int, window time.Duration) RestartPolicy {
+	return RestartPolicy{kind: restartMaxRestarts, maxRestarts: n, window: window}
+}
+
+// BackoffFunc computes how long to wait before the attempt'th restart (0-indexed).
+type BackoffFunc func(attempt int) time.Duration
+
+// ExponentialBackoff returns a BackoffFunc doubling from base up to max, with
+// jitter in [0, d/2] added to the halved delay to avoid thundering herds.
+func ExponentialBackoff(base, max time.Duration) BackoffFunc {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 0; i < attempt && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		half := d / 2
+		return half + time.Duration(rand.Int63n(int64(half)+1))
+	}
+}
+
+// SupervisorError records that a goroutine supervised by an Arbiter exhausted
+// its restart budget.
+type SupervisorError struct {
+	Arbiter *Arbiter
+	Err     error
+}
+
+func (e *SupervisorError) Error() string { return e.Err.Error() }
+func (e *SupervisorError) Unwrap() error { return e.Err }
+
+// GoOption configures supervision for a single Go call.
+type GoOption func(*goConfig)
+
+type goConfig struct {
+	policy           RestartPolicy
+	backoff          BackoffFunc
+	escalate         bool
+	escalateShutdown bool
+}
+
+// WithRestartPolicy sets the RestartPolicy for a Go call. The default is OneShot.
+func WithRestartPolicy(policy RestartPolicy) GoOption {
+	return func(c *goConfig) { c.policy = policy }
+}
+
+// WithBackoff sets the delay applied between restarts. Without it, restarts happen immediately.
+func WithBackoff(backoff BackoffFunc) GoOption {
+	return func(c *goConfig) { c.backoff = backoff }
+}
+
+// WithEscalation makes an exhausted restart budget propagate a SupervisorError
+// to the arbiter's parent. If shutdownParent is true, the parent is also shut down.
+func WithEscalation(shutdownParent bool) GoOption {
+	return func(c *goConfig) {
+		c.escalate = true
+		c.escalateShutdown = shutdownParent
+	}
+}
+
+func newGoConfig(opts []GoOption) goConfig {
+	cfg := goConfig{policy: OneShot}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// SupervisorErrors returns the SupervisorErrors recorded on this arbiter, in the
+// order they occurred. This includes errors escalated up from children.
+func (a *Arbiter) SupervisorErrors() []*SupervisorError {
+	a.supervisorMu.Lock()
+	defer a.supervisorMu.Unlock()
+	errs := make([]*SupervisorError, len(a.supervisorErrors))
+	copy(errs, a.supervisorErrors)
+	return errs
+}
+
+func (a *Arbiter) recordSupervisorError(err *SupervisorError) {
+	a.supervisorMu.Lock()
+	defer a.supervisorMu.Unlock()
+	a.supervisorErrors = append(a.supervisorErrors, err)
+}
+
+// runProtected runs proc, recovering any panic and reporting it through the
+// arbiter's panic hooks (see OnPanic).
+func (a *Arbiter) runProtected(proc func()) (panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			a.firePanic(r, debug.Stack())
+		}
+	}()
+	proc()
+	return false
+}
+
+// runProtectedE runs proc, recovering any panic and reporting it through the
+// arbiter's panic hooks (see OnPanic). A panic is also wrapped into the returned
+// error alongside proc's own return value.
+func (a *Arbiter) runProtectedE(proc func() error) (err error, panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			a.firePanic(r, debug.Stack())
+			err = fmt.Errorf("arbiter: panic: %v", r)
+		}
+	}()
+	return proc(), false
+}
+
+// trackRestart records a restart attempt against cfg's MaxRestarts window and
+// reports whether the budget has now been exceeded. It is a no-op for other policies.
+func (a *Arbiter) trackRestart(cfg goConfig, restarts *[]time.Time) (exceeded bool) {
+	if cfg.policy.kind != restartMaxRestarts {
+		return false
+	}
+	cutoff := a.clock.Now().Add(-cfg.policy.window)
+	kept := (*restarts)[:0]
+	for _, at := range *restarts {
+		if at.After(cutoff) {
+			kept = append(kept, at)
+		}
+	}
+	*restarts = append(kept, a.clock.Now())
+	return len(*restarts) > cfg.policy.maxRestarts
+}
+
+// supervise runs proc under cfg's RestartPolicy, relaunching it as configured
+// until the policy says to stop or the arbiter is shut down.
+func (a *Arbiter) supervise(proc func(), cfg goConfig) {
+	var restarts []time.Time
+	for attempt := 0; ; attempt++ {
+		// Go already gated the first attempt synchronously via ShouldRun before
+		// spawning this goroutine; re-checking it here too races against a
+		// concurrent Shutdown and can skip proc entirely. Only a restart needs
+		// the check, since time has passed since the spawn-time decision.
+		if attempt > 0 && !a.ShouldRun() {
+			return
+		}
+		panicked := a.runProtected(proc)
+		if !cfg.policy.kind.restartsOn(panicked) || !a.ShouldRun() {
+			return
+		}
+
+		if a.trackRestart(cfg, &restarts) {
+			a.exceedRestartBudget(cfg)
+			return
+		}
+
+		if cfg.backoff != nil {
+			if d := cfg.backoff(attempt); d > 0 {
+				a.clock.Sleep(d)
+			}
+		}
+	}
+}
+
+// superviseE is like supervise but for GoE's error-returning proc: every non-nil
+// error (including panics wrapped as errors) is recorded via Err, in addition to
+// the restart decision being driven by cfg's RestartPolicy.
+func (a *Arbiter) superviseE(proc func() error, cfg goConfig) {
+	var restarts []time.Time
+	for attempt := 0; ; attempt++ {
+		// See supervise: Go/GoE already gated attempt 0 synchronously, so only
+		// a restart needs to re-check ShouldRun here.
+		if attempt > 0 && !a.ShouldRun() {
+			return
+		}
+		err, panicked := a.runProtectedE(proc)
+		if err != nil {
+			a.recordError(err)
+		}
+		if !cfg.policy.kind.restartsOn(panicked) || !a.ShouldRun() {
+			return
+		}
+
+		if a.trackRestart(cfg, &restarts) {
+			a.exceedRestartBudget(cfg)
+			return
+		}
+
+		if cfg.backoff != nil {
+			if d := cfg.backoff(attempt); d > 0 {
+				a.clock.Sleep(d)
+			}
+		}
+	}
+}
+
+func (a *Arbiter) exceedRestartBudget(cfg goConfig) {
+	err := &SupervisorError{
+		Arbiter: a,
+		Err:     fmt.Errorf("arbiter: exceeded restart budget of %d restarts within %s", cfg.policy.maxRestarts, cfg.policy.window),
+	}
+	a.recordSupervisorError(err)
+	if cfg.escalate && a.parent != nil {
+		a.parent.recordSupervisorError(err)
+		if cfg.escalateShutdown {
+			a.parent.Shutdown()
+		}
+	}
+}